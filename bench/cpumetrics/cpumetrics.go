@@ -0,0 +1,55 @@
+// Package cpumetrics samples runtime/metrics CPU-class counters around a
+// benchmark run, so on-CPU dispatch cost can be told apart from GC assist,
+// scavenging, and scheduler idle time hiding inside a wall-clock delta.
+package cpumetrics
+
+import "runtime/metrics"
+
+// names lists the runtime/metrics samples this package tracks, in the order
+// they're stored in a Snapshot.
+var names = []string{
+	"/cpu/classes/user:cpu-seconds",
+	"/cpu/classes/gc/total:cpu-seconds",
+	"/cpu/classes/gc/mark/assist:cpu-seconds",
+	"/cpu/classes/scavenge/total:cpu-seconds",
+	"/cpu/classes/idle:cpu-seconds",
+}
+
+// Snapshot holds cumulative CPU-seconds for each tracked class, as reported
+// by runtime/metrics since process start.
+type Snapshot struct {
+	UserCPUSeconds      float64
+	GCTotalCPUSeconds   float64
+	GCMarkAssistSeconds float64
+	ScavengeCPUSeconds  float64
+	IdleCPUSeconds      float64
+}
+
+// Sample reads the current value of every tracked counter.
+func Sample() Snapshot {
+	samples := make([]metrics.Sample, len(names))
+	for i, n := range names {
+		samples[i].Name = n
+	}
+	metrics.Read(samples)
+
+	return Snapshot{
+		UserCPUSeconds:      samples[0].Value.Float64(),
+		GCTotalCPUSeconds:   samples[1].Value.Float64(),
+		GCMarkAssistSeconds: samples[2].Value.Float64(),
+		ScavengeCPUSeconds:  samples[3].Value.Float64(),
+		IdleCPUSeconds:      samples[4].Value.Float64(),
+	}
+}
+
+// Diff returns the CPU-seconds accumulated in each class between a and b,
+// i.e. b-a.
+func Diff(a, b Snapshot) Snapshot {
+	return Snapshot{
+		UserCPUSeconds:      b.UserCPUSeconds - a.UserCPUSeconds,
+		GCTotalCPUSeconds:   b.GCTotalCPUSeconds - a.GCTotalCPUSeconds,
+		GCMarkAssistSeconds: b.GCMarkAssistSeconds - a.GCMarkAssistSeconds,
+		ScavengeCPUSeconds:  b.ScavengeCPUSeconds - a.ScavengeCPUSeconds,
+		IdleCPUSeconds:      b.IdleCPUSeconds - a.IdleCPUSeconds,
+	}
+}
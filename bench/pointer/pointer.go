@@ -0,0 +1,46 @@
+// Package pointer benchmarks a pointer-receiver method that returns its
+// receiver, called directly on its concrete type versus through the
+// `Summable` interface it implements.
+package pointer
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+
+// Int provides an `int64` that implements the `Summable` interface.
+type Int int64
+
+// Sum simply adds two `Int`s.
+func (i *Int) Sum(i2 Int) *Int { *i += i2; return i }
+
+type Summable interface {
+	Sum(i Int) *Int
+}
+
+// -----------------------------------------------------------------------------
+
+// RunConcrete calls Sum directly on the concrete `*Int` type. Exported so it
+// can be driven both by `go test -bench` (via BenchmarkConcrete in
+// pointer_test.go) and by cmd/interface-bench's own comparison table.
+func RunConcrete(b *testing.B) {
+	var zero Int
+	i := &zero
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		i = i.Sum(Int(10))
+	}
+	_ = i
+}
+
+// RunInterface calls Sum through the `Summable` interface. Exported so it
+// can be driven both by `go test -bench` (via BenchmarkInterface in
+// pointer_test.go) and by cmd/interface-bench's own comparison table.
+func RunInterface(b *testing.B) {
+	var zero Int
+	var i Summable = &zero
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		i = i.Sum(Int(10))
+	}
+	_ = i
+}
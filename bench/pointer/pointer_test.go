@@ -0,0 +1,11 @@
+package pointer
+
+import "testing"
+
+// BenchmarkConcrete lets `go test -bench=. ./bench/pointer` (and thus
+// benchstat) exercise RunConcrete directly.
+func BenchmarkConcrete(b *testing.B) { RunConcrete(b) }
+
+// BenchmarkInterface lets `go test -bench=. ./bench/pointer` (and thus
+// benchstat) exercise RunInterface directly.
+func BenchmarkInterface(b *testing.B) { RunInterface(b) }
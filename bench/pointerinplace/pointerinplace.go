@@ -0,0 +1,46 @@
+// Package pointerinplace benchmarks a void pointer-receiver method that
+// mutates its receiver in place, called directly on its concrete type
+// versus through the `Summable` interface it implements.
+package pointerinplace
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+
+// Int provides an `int64` that implements the `Summable` interface.
+type Int int64
+
+// Sum simply adds two `Int`s.
+func (i *Int) Sum(i2 Int) { *i += i2 }
+
+type Summable interface {
+	Sum(i Int)
+}
+
+// -----------------------------------------------------------------------------
+
+// RunConcrete calls Sum directly on the concrete `*Int` type. Exported so it
+// can be driven both by `go test -bench` (via BenchmarkConcrete in
+// pointerinplace_test.go) and by cmd/interface-bench's own comparison table.
+func RunConcrete(b *testing.B) {
+	var zero Int
+	i := &zero
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		i.Sum(Int(10))
+	}
+	_ = i
+}
+
+// RunInterface calls Sum through the `Summable` interface. Exported so it
+// can be driven both by `go test -bench` (via BenchmarkInterface in
+// pointerinplace_test.go) and by cmd/interface-bench's own comparison table.
+func RunInterface(b *testing.B) {
+	var zero Int
+	var i Summable = &zero
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		i.Sum(Int(10))
+	}
+	_ = i
+}
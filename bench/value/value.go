@@ -0,0 +1,43 @@
+// Package value benchmarks a value-receiver method called directly on its
+// concrete type versus through the `Summable` interface it implements.
+package value
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+
+// Int provides an `int64` that implements the `Summable` interface.
+type Int int64
+
+// Sum simply adds two `Int`s.
+func (i Int) Sum(i2 Int) Int { return i + i2 }
+
+type Summable interface {
+	Sum(i Int) Int
+}
+
+// -----------------------------------------------------------------------------
+
+// RunConcrete calls Sum directly on the concrete `Int` type. Exported so it
+// can be driven both by `go test -bench` (via BenchmarkConcrete in
+// value_test.go) and by cmd/interface-bench's own comparison table.
+func RunConcrete(b *testing.B) {
+	var i Int
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		i = i.Sum(Int(10))
+	}
+	_ = i
+}
+
+// RunInterface calls Sum through the `Summable` interface. Exported so it
+// can be driven both by `go test -bench` (via BenchmarkInterface in
+// value_test.go) and by cmd/interface-bench's own comparison table.
+func RunInterface(b *testing.B) {
+	var i Summable = Int(0)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		i = i.Sum(Int(10))
+	}
+	_ = i
+}
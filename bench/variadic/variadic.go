@@ -0,0 +1,90 @@
+// Package variadic benchmarks the cost of passing arguments through
+// `...interface{}` and recovering them via type assertion, versus a typed
+// `...Int` slice, versus a `...Summable` interface slice. This is a
+// distinct axis from the receiver-dispatch benchmarks: it isolates the
+// per-argument assertion/dispatch overhead of variadic packing from
+// method-dispatch cost. None of these packs allocates here: the three
+// packs themselves stay on the stack, the boxed `interface{}` elements do
+// not escape, and the boxed `Summable` elements, which do escape through
+// the interface call, are constants the compiler backs with static data.
+// So `allocs/op` reads 0 for all three, and the ns/op delta is assertion,
+// interface-call and iteration overhead.
+package variadic
+
+import "testing"
+
+// -----------------------------------------------------------------------------
+
+// Int provides an `int64` that implements the `Summable` interface.
+type Int int64
+
+// Sum simply adds two `Int`s.
+func (i Int) Sum(i2 Int) Int { return i + i2 }
+
+type Summable interface {
+	Sum(i Int) Int
+}
+
+// -----------------------------------------------------------------------------
+
+// SumAny sums nums by asserting each boxed `interface{}` back to `Int`.
+func SumAny(nums ...interface{}) Int {
+	var total Int
+	for _, n := range nums {
+		total = total.Sum(n.(Int))
+	}
+	return total
+}
+
+// SumTyped sums nums passed as a typed `Int` slice, no boxing involved.
+func SumTyped(nums ...Int) Int {
+	var total Int
+	for _, n := range nums {
+		total = total.Sum(n)
+	}
+	return total
+}
+
+// SumIface sums nums passed as a `Summable` slice, one interface call per element.
+func SumIface(nums ...Summable) Int {
+	var total Int
+	for _, n := range nums {
+		total = n.Sum(total)
+	}
+	return total
+}
+
+// -----------------------------------------------------------------------------
+
+// BenchmarkSumAny calls SumAny with 10 literal `Int` arguments, built into
+// a fresh `[]interface{}` on every b.N iteration.
+func BenchmarkSumAny(b *testing.B) {
+	var total Int
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		total = SumAny(Int(0), Int(1), Int(2), Int(3), Int(4), Int(5), Int(6), Int(7), Int(8), Int(9))
+	}
+	_ = total
+}
+
+// BenchmarkSumTyped calls SumTyped with 10 literal `Int` arguments, packed
+// into a fresh `[]Int` on every b.N iteration with no boxing involved.
+func BenchmarkSumTyped(b *testing.B) {
+	var total Int
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		total = SumTyped(Int(0), Int(1), Int(2), Int(3), Int(4), Int(5), Int(6), Int(7), Int(8), Int(9))
+	}
+	_ = total
+}
+
+// BenchmarkSumIface calls SumIface with 10 literal `Int` arguments, built
+// into a fresh `[]Summable` on every b.N iteration.
+func BenchmarkSumIface(b *testing.B) {
+	var total Int
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		total = SumIface(Int(0), Int(1), Int(2), Int(3), Int(4), Int(5), Int(6), Int(7), Int(8), Int(9))
+	}
+	_ = total
+}
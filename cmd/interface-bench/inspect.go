@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// inspectTargets are the packages whose compiler diagnostics `inspect`
+// reports on, one per concrete/interface dispatch variant.
+var inspectTargets = []struct {
+	name string
+	pkg  string
+}{
+	{name: "value-receiver", pkg: "./bench/value"},
+	{name: "pointer-receiver", pkg: "./bench/pointer"},
+	{name: "pointer-receiver-void", pkg: "./bench/pointerinplace"},
+}
+
+// callsiteReport summarizes what the compiler did at a package's Sum
+// callsite, as gleaned from `go build -gcflags="-m -m -l=4"` diagnostics.
+type callsiteReport struct {
+	variant        string
+	inlined        bool
+	escapesToHeap  bool
+	devirtualized  bool
+	resolvedMethod string
+}
+
+var (
+	reInline  = regexp.MustCompile(`inlining call to \S*\.Sum`)
+	reEscape  = regexp.MustCompile(`\.Sum\(.*\) escapes to heap|moved to heap: zero|leaking param: i$`)
+	reDevirt  = regexp.MustCompile(`devirtualizing (\S+\.Sum) to (\S+)`)
+	reCanline = regexp.MustCompile(`can inline (\S*)\.Sum`)
+)
+
+// runInspect shells out to the Go compiler's `-m -m` diagnostics for each
+// dispatch variant and prints a per-callsite table showing whether Sum was
+// inlined, whether its receiver escaped to the heap, and whether the
+// interface call was devirtualized to a concrete method. When disasm is
+// set, it additionally dumps the Sum callsite's disassembly and highlights
+// the CALL-vs-direct-instruction difference between variants.
+func runInspect(disasm bool) error {
+	fmt.Printf("%-22s %-8s %-9s %-8s %-20s\n", "variant", "inlined", "escapes", "devirt", "resolved")
+	for _, t := range inspectTargets {
+		out, err := exec.Command("go", "build", "-gcflags=-m -m -l=4", "-o", "/dev/null", t.pkg).CombinedOutput()
+		if err != nil {
+			// `go build` with `-m` diagnostics exits non-zero on some
+			// toolchains even when the diagnostics themselves are useful;
+			// keep parsing what was captured instead of bailing out.
+			fmt.Printf("# %s: go build failed: %v\n", t.pkg, err)
+		}
+		r := parseCallsiteReport(t.name, string(out))
+		printCallsiteReport(r)
+	}
+
+	if disasm {
+		fmt.Println()
+		for _, t := range inspectTargets {
+			if err := dumpDisasm(t.name, t.pkg); err != nil {
+				fmt.Printf("# %s: %v\n", t.pkg, err)
+			}
+		}
+	}
+	return nil
+}
+
+// dumpDisasm builds pkg, disassembles its Sum callsite via `go tool
+// objdump`, and prints only the lines that contain a CALL or an ADDQ so
+// the direct-call vs indirect-dispatch difference stands out.
+func dumpDisasm(variant, pkg string) error {
+	tmp, err := os.MkdirTemp("", "interface-bench-disasm")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	bin := filepath.Join(tmp, "pkg.bin")
+	if out, err := exec.Command("go", "build", "-o", bin, pkg).CombinedOutput(); err != nil {
+		return fmt.Errorf("go build: %w\n%s", err, out)
+	}
+
+	out, err := exec.Command("go", "tool", "objdump", "-s", `\.Run(Concrete|Interface)$`, bin).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("go tool objdump: %w\n%s", err, out)
+	}
+
+	fmt.Printf("# %s\n", variant)
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.Contains(line, "CALL") || strings.Contains(line, "ADDQ") {
+			fmt.Println(line)
+		}
+	}
+	return nil
+}
+
+func parseCallsiteReport(variant, diagnostics string) callsiteReport {
+	r := callsiteReport{variant: variant, resolvedMethod: "-"}
+	for _, line := range strings.Split(diagnostics, "\n") {
+		if reInline.MatchString(line) || reCanline.MatchString(line) {
+			r.inlined = true
+		}
+		if reEscape.MatchString(line) {
+			r.escapesToHeap = true
+		}
+		if m := reDevirt.FindStringSubmatch(line); m != nil {
+			r.devirtualized = true
+			r.resolvedMethod = m[2]
+		}
+	}
+	return r
+}
+
+func printCallsiteReport(r callsiteReport) {
+	fmt.Printf("%-22s %-8s %-9s %-8s %-20s\n",
+		r.variant, yesNo(r.inlined), yesNo(r.escapesToHeap), yesNo(r.devirtualized), r.resolvedMethod)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,157 @@
+// Command interface-bench runs every concrete-vs-interface benchmark variant
+// and prints a single comparison table, so results stay reproducible across
+// Go toolchains and machines instead of relying on hand-rolled timing loops.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/teh-cmc/interface-bench/bench/cpumetrics"
+	"github.com/teh-cmc/interface-bench/bench/genericpointer"
+	"github.com/teh-cmc/interface-bench/bench/genericvalue"
+	"github.com/teh-cmc/interface-bench/bench/pointer"
+	"github.com/teh-cmc/interface-bench/bench/pointerinplace"
+	"github.com/teh-cmc/interface-bench/bench/value"
+	"github.com/teh-cmc/interface-bench/bench/variadic"
+
+	"github.com/pkg/profile"
+)
+
+// variant groups the concrete/interface/generic benchmark triplet for a
+// single dispatch style, e.g. value receiver or pointer receiver.
+// genericFn is nil for variants that have no generic counterpart.
+type variant struct {
+	name        string
+	concreteFn  func(b *testing.B)
+	interfaceFn func(b *testing.B)
+	genericFn   func(b *testing.B)
+}
+
+var variants = []variant{
+	{name: "value-receiver", concreteFn: value.RunConcrete, interfaceFn: value.RunInterface, genericFn: genericvalue.BenchmarkGeneric},
+	{name: "pointer-receiver", concreteFn: pointer.RunConcrete, interfaceFn: pointer.RunInterface, genericFn: genericpointer.BenchmarkGeneric},
+	{name: "pointer-receiver-void", concreteFn: pointerinplace.RunConcrete, interfaceFn: pointerinplace.RunInterface},
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "inspect" {
+		inspectFlags := flag.NewFlagSet("inspect", flag.ExitOnError)
+		disasm := inspectFlags.Bool("disasm", false, "also dump disassembly of the Sum callsite for each variant")
+		inspectFlags.Parse(os.Args[2:])
+
+		if err := runInspect(*disasm); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "pgo" {
+		if err := runPGO(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Register and parse `testing`'s own flags so -test.benchtime governs
+	// testing.Benchmark below the same way it does under `go test -bench`.
+	// Other flags such as -test.cpu are accepted but have no effect here.
+	testing.Init()
+	flag.Parse()
+
+	defer profile.Start(profile.CPUProfile).Stop()
+
+	printHeader()
+	for _, v := range variants {
+		concrete, concreteCPU := benchWithCPU(v.concreteFn)
+		iface, ifaceCPU := benchWithCPU(v.interfaceFn)
+
+		printRow(v.name, "concrete", concrete, 0, &concreteCPU)
+		printRow(v.name, "interface", iface, delta(nsPerOp(concrete), nsPerOp(iface)), &ifaceCPU)
+
+		if v.genericFn != nil {
+			generic := testing.Benchmark(v.genericFn)
+			printRow(v.name, "generic", generic, delta(nsPerOp(concrete), nsPerOp(generic)), nil)
+		}
+	}
+
+	fmt.Println()
+	runVariadic()
+}
+
+// benchWithCPU runs fn via testing.Benchmark and reports the CPU-seconds
+// accrued in each runtime/metrics CPU class while it ran, so the
+// wall-clock delta between dispatch styles can be attributed to actual
+// on-CPU work versus GC assist, scavenging, or scheduler idle time.
+func benchWithCPU(fn func(b *testing.B)) (testing.BenchmarkResult, cpumetrics.Snapshot) {
+	before := cpumetrics.Sample()
+	result := testing.Benchmark(fn)
+	after := cpumetrics.Sample()
+	return result, cpumetrics.Diff(before, after)
+}
+
+// runVariadic prints a second table measuring the cost of `...interface{}`
+// versus typed and interface-typed variadic argument packs, separately
+// from the receiver-dispatch table above.
+func runVariadic() {
+	printHeader()
+
+	typed := testing.Benchmark(variadic.BenchmarkSumTyped)
+	anyResult := testing.Benchmark(variadic.BenchmarkSumAny)
+	iface := testing.Benchmark(variadic.BenchmarkSumIface)
+
+	printRow("variadic", "typed", typed, 0, nil)
+	printRow("variadic", "interface", iface, delta(nsPerOp(typed), nsPerOp(iface)), nil)
+	printRow("variadic", "any", anyResult, delta(nsPerOp(typed), nsPerOp(anyResult)), nil)
+}
+
+// nsPerOp returns r's nanoseconds-per-op as a float64 computed directly
+// from r.T/r.N, unlike testing.BenchmarkResult.NsPerOp which truncates to
+// an int64 and collapses any sub-nanosecond/op result to zero.
+func nsPerOp(r testing.BenchmarkResult) float64 {
+	if r.N == 0 {
+		return 0
+	}
+	return float64(r.T) / float64(r.N)
+}
+
+// delta returns the percentage by which b is slower than a. It returns 0
+// when a is exactly zero (no baseline to compare against), which can only
+// happen if the baseline benchmark ran zero iterations.
+func delta(a, b float64) float64 {
+	if a == 0 {
+		return 0
+	}
+	return (b - a) / a * 100
+}
+
+// printHeader prints the column header shared by every table: wall-clock
+// ns/op alongside the CPU-class breakdown sampled around the same run.
+func printHeader() {
+	fmt.Printf("%-22s %-10s %12s %12s %10s %8s %10s %10s %10s %10s %10s\n",
+		"variant", "dispatch", "ns/op", "B/op", "allocs/op", "delta%",
+		"user-cpu", "gc-cpu", "gc-assist", "scavenge", "idle-cpu")
+}
+
+// printRow prints one table row. cpu may be nil for benchmarks that weren't
+// sampled with benchWithCPU (generics, variadics), in which case the
+// CPU-class columns are left blank.
+func printRow(name, dispatch string, r testing.BenchmarkResult, deltaPct float64, cpu *cpumetrics.Snapshot) {
+	deltaStr := "-"
+	if dispatch != "concrete" && dispatch != "typed" {
+		deltaStr = fmt.Sprintf("%+.1f%%", deltaPct)
+	}
+
+	cpuCols := fmt.Sprintf("%10s %10s %10s %10s %10s", "-", "-", "-", "-", "-")
+	if cpu != nil {
+		cpuCols = fmt.Sprintf("%10.3f %10.3f %10.3f %10.3f %10.3f",
+			cpu.UserCPUSeconds, cpu.GCTotalCPUSeconds, cpu.GCMarkAssistSeconds, cpu.ScavengeCPUSeconds, cpu.IdleCPUSeconds)
+	}
+
+	fmt.Printf("%-22s %-10s %12.3f %12d %10d %8s %s\n",
+		name, dispatch, nsPerOp(r), r.AllocedBytesPerOp(), r.AllocsPerOp(), deltaStr, cpuCols)
+}
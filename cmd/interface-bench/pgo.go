@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// pgoVariants are the interface-dispatch variants PGO devirtualization is
+// benchmarked against.
+var pgoVariants = []string{"value-receiver", "pointer-receiver", "pointer-receiver-void"}
+
+// runPGO builds and runs each variant's interface loop in three modes —
+// plain, PGO-guided using a profile captured from a warm-up run, and with
+// devirtualization explicitly disabled — and reports the ns/op speedup
+// attributable to PGO devirtualization.
+func runPGO() error {
+	tmp, err := os.MkdirTemp("", "interface-bench-pgo")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	fmt.Printf("%-22s %12s %12s %12s %10s\n", "variant", "plain", "pgo", "no-devirt", "speedup%")
+	for _, variant := range pgoVariants {
+		profileDir := filepath.Join(tmp, variant)
+		if err := os.MkdirAll(profileDir, 0o755); err != nil {
+			return err
+		}
+
+		// Warm-up run: capture a CPU profile to seed the PGO build.
+		if _, err := runLoop(tmp, variant, "", profileDir); err != nil {
+			return fmt.Errorf("warm-up run for %s: %w", variant, err)
+		}
+		pgoFile := filepath.Join(tmp, variant+".pgo")
+		if err := os.Rename(filepath.Join(profileDir, "cpu.pprof"), pgoFile); err != nil {
+			return fmt.Errorf("collect profile for %s: %w", variant, err)
+		}
+
+		plain, err := runLoop(tmp, variant, "", "")
+		if err != nil {
+			return fmt.Errorf("plain run for %s: %w", variant, err)
+		}
+		pgo, err := runLoop(tmp, variant, pgoFile, "")
+		if err != nil {
+			return fmt.Errorf("pgo run for %s: %w", variant, err)
+		}
+		noDevirt, err := runLoopNoDevirt(tmp, variant, pgoFile)
+		if err != nil {
+			return fmt.Errorf("no-devirt run for %s: %w", variant, err)
+		}
+
+		fmt.Printf("%-22s %12d %12d %12d %10s\n",
+			variant, plain, pgo, noDevirt, fmt.Sprintf("%+.1f%%", -delta(float64(noDevirt), float64(pgo))))
+	}
+	return nil
+}
+
+// runLoop builds ./cmd/pgorunner for variant — guided by a PGO profile at
+// pgoFile if non-empty, and writing a CPU profile to profileDir if
+// non-empty — then runs it and parses the printed ns/op.
+func runLoop(tmp, variant, pgoFile, profileDir string) (int64, error) {
+	bin := filepath.Join(tmp, variant+"-bin")
+	args := []string{"build", "-o", bin}
+	if pgoFile != "" {
+		args = append(args, "-pgo="+pgoFile)
+	}
+	args = append(args, "./cmd/pgorunner")
+	if out, err := exec.Command("go", args...).CombinedOutput(); err != nil {
+		return 0, fmt.Errorf("go build: %w\n%s", err, out)
+	}
+
+	runArgs := []string{"-variant=" + variant}
+	if profileDir != "" {
+		runArgs = append(runArgs, "-profile-path="+profileDir)
+	}
+	var stdout bytes.Buffer
+	cmd := exec.Command(bin, runArgs...)
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return 0, fmt.Errorf("run: %w", err)
+	}
+	return strconv.ParseInt(strings.TrimSpace(stdout.String()), 10, 64)
+}
+
+// runLoopNoDevirt builds the same PGO-guided binary as runLoop but with
+// devirtualization explicitly disabled, so it differs from the "pgo"
+// column by exactly the devirtualization pass and isolates the ns/op that
+// PGO would otherwise recover.
+func runLoopNoDevirt(tmp, variant, pgoFile string) (int64, error) {
+	bin := filepath.Join(tmp, variant+"-nodevirt-bin")
+	out, err := exec.Command("go", "build", "-pgo="+pgoFile, "-gcflags=-d=pgodevirtualize=0", "-o", bin, "./cmd/pgorunner").CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("go build: %w\n%s", err, out)
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(bin, "-variant="+variant)
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return 0, fmt.Errorf("run: %w", err)
+	}
+	return strconv.ParseInt(strings.TrimSpace(stdout.String()), 10, 64)
+}
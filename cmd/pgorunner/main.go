@@ -0,0 +1,75 @@
+// Command pgorunner runs a single variant's interface-dispatch loop outside
+// of testing.B, so the binary can be rebuilt with different compiler flags
+// (plain, PGO-guided, or with devirtualization disabled) and timed in
+// isolation by cmd/interface-bench's "pgo" subcommand.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/pkg/profile"
+
+	"github.com/teh-cmc/interface-bench/bench/pointer"
+	"github.com/teh-cmc/interface-bench/bench/pointerinplace"
+	"github.com/teh-cmc/interface-bench/bench/value"
+)
+
+const nbOps = 1e7
+
+func main() {
+	variant := flag.String("variant", "value-receiver", "dispatch variant to run (value-receiver, pointer-receiver, pointer-receiver-void)")
+	profilePath := flag.String("profile-path", "", "directory to write a CPU profile to; empty disables profiling")
+	flag.Parse()
+
+	if *profilePath != "" {
+		defer profile.Start(profile.CPUProfile, profile.ProfilePath(*profilePath), profile.NoShutdownHook).Stop()
+	}
+
+	var elapsed time.Duration
+	switch *variant {
+	case "value-receiver":
+		elapsed = runValueInterface()
+	case "pointer-receiver":
+		elapsed = runPointerInterface()
+	case "pointer-receiver-void":
+		elapsed = runPointerVoidInterface()
+	default:
+		fmt.Println("unknown variant:", *variant)
+		return
+	}
+
+	fmt.Println(elapsed.Nanoseconds() / nbOps)
+}
+
+func runValueInterface() time.Duration {
+	var i value.Summable = value.Int(0)
+	start := time.Now()
+	for n := 0; n < nbOps; n++ {
+		i = i.Sum(value.Int(10))
+	}
+	_ = i
+	return time.Since(start)
+}
+
+func runPointerInterface() time.Duration {
+	var zero pointer.Int
+	var i pointer.Summable = &zero
+	start := time.Now()
+	for n := 0; n < nbOps; n++ {
+		i = i.Sum(pointer.Int(10))
+	}
+	_ = i
+	return time.Since(start)
+}
+
+func runPointerVoidInterface() time.Duration {
+	var zero pointerinplace.Int
+	var i pointerinplace.Summable = &zero
+	start := time.Now()
+	for n := 0; n < nbOps; n++ {
+		i.Sum(pointerinplace.Int(10))
+	}
+	return time.Since(start)
+}